@@ -1003,6 +1003,24 @@ func filterBalloons(balloons []*Balloon, test func(*Balloon) bool) (ret []*Ballo
 	return
 }
 
+// AllocationStability returns the fraction of CPUs allocated in prev
+// that are still allocated to the same balloon in curr. Balloons are
+// identified by their keys in the maps. 1.0 means no CPU has moved.
+func AllocationStability(prev, curr map[string]cpuset.CPUSet) float64 {
+	total := 0
+	stayed := 0
+	for name, prevCpus := range prev {
+		total += prevCpus.Size()
+		if currCpus, ok := curr[name]; ok {
+			stayed += prevCpus.Intersection(currCpus).Size()
+		}
+	}
+	if total == 0 {
+		return 1.0
+	}
+	return float64(stayed) / float64(total)
+}
+
 // availableMilliCPU returns mCPUs available in a balloon.
 func (p *balloons) availableMilliCpus(balloon *Balloon) int64 {
 	cpuAvail := int64(balloon.Cpus.Size() * 1000)
@@ -16,6 +16,8 @@ package balloons
 
 import (
 	"testing"
+
+	"k8s.io/kubernetes/pkg/kubelet/cm/cpuset"
 )
 
 func TestChangesBalloons(t *testing.T) {
@@ -94,3 +96,60 @@ func TestChangesBalloons(t *testing.T) {
 		})
 	}
 }
+
+func TestAllocationStability(t *testing.T) {
+	tcases := []struct {
+		name          string
+		prev          map[string]cpuset.CPUSet
+		curr          map[string]cpuset.CPUSet
+		expectedValue float64
+	}{
+		{
+			name:          "no allocations",
+			expectedValue: 1.0,
+		},
+		{
+			name: "identical allocations",
+			prev: map[string]cpuset.CPUSet{
+				"bln0": cpuset.MustParse("0-3"),
+				"bln1": cpuset.MustParse("4-5"),
+			},
+			curr: map[string]cpuset.CPUSet{
+				"bln0": cpuset.MustParse("0-3"),
+				"bln1": cpuset.MustParse("4-5"),
+			},
+			expectedValue: 1.0,
+		},
+		{
+			name: "fully reshuffled allocations",
+			prev: map[string]cpuset.CPUSet{
+				"bln0": cpuset.MustParse("0-3"),
+				"bln1": cpuset.MustParse("4-7"),
+			},
+			curr: map[string]cpuset.CPUSet{
+				"bln0": cpuset.MustParse("4-7"),
+				"bln1": cpuset.MustParse("0-3"),
+			},
+			expectedValue: 0.0,
+		},
+		{
+			name: "one balloon grows, another is deleted",
+			prev: map[string]cpuset.CPUSet{
+				"bln0": cpuset.MustParse("0-1"),
+				"bln1": cpuset.MustParse("2-3"),
+			},
+			curr: map[string]cpuset.CPUSet{
+				"bln0": cpuset.MustParse("0-3"),
+			},
+			expectedValue: 0.5,
+		},
+	}
+	for _, tc := range tcases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := AllocationStability(tc.prev, tc.curr)
+			if value != tc.expectedValue {
+				t.Errorf("Expected return value %v but got %v", tc.expectedValue, value)
+			}
+		})
+	}
+}